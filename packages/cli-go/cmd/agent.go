@@ -2,15 +2,41 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/ghostspeak/cli-go/internal/ape"
 	"github.com/ghostspeak/cli-go/internal/domain"
+	"github.com/ghostspeak/cli-go/internal/services"
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
 )
 
+var (
+	flagAgentManifest string
+
+	flagListFormat      string
+	flagListOutput      string
+	flagAnalyticsFormat string
+	flagAnalyticsOutput string
+)
+
+// openExportWriter returns a writer for the given output path, or os.Stdout when path is
+// empty, along with a close func the caller should always invoke.
+func openExportWriter(path string) (io.Writer, func() error, error) {
+	if path == "" {
+		return os.Stdout, func() error { return nil }, nil
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create output file: %w", err)
+	}
+	return f, f.Close, nil
+}
+
 var agentCmd = &cobra.Command{
 	Use:   "agent",
 	Short: "Manage AI agents",
@@ -28,6 +54,10 @@ var agentRegisterCmd = &cobra.Command{
 This will create an on-chain account for your agent with metadata stored on IPFS.
 You will be prompted for agent details and your wallet password.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if flagAgentManifest != "" {
+			return runAgentRegisterManifest(flagAgentManifest)
+		}
+
 		// Get agent details
 		var name, description, capabilitiesStr string
 
@@ -88,7 +118,7 @@ You will be prompted for agent details and your wallet password.`,
 			Version:      "1.0.0",
 		}
 
-		agent, err := application.AgentService.RegisterAgent(params, string(passwordBytes))
+		agent, err := agentServicer().RegisterAgent(params, string(passwordBytes))
 		if err != nil {
 			return fmt.Errorf("failed to register agent: %w", err)
 		}
@@ -112,12 +142,87 @@ You will be prompted for agent details and your wallet password.`,
 	},
 }
 
+// runAgentRegisterManifest bulk-registers every agent described in a manifest file,
+// unlocking the wallet once instead of prompting per agent.
+func runAgentRegisterManifest(path string) error {
+	if application == nil {
+		return fmt.Errorf("manifest registration is not supported in --remote mode yet")
+	}
+
+	manifest, err := services.LoadAgentManifest(path)
+	if err != nil {
+		return fmt.Errorf("failed to load manifest: %w", err)
+	}
+
+	fmt.Printf("Registering %d agents from %s\n", len(manifest.Agents), path)
+
+	actor := ""
+	if wallet, err := application.WalletService.GetActiveWallet(); err == nil {
+		actor = wallet.PublicKey
+	}
+	if err := ape.AuthorizeManifest(application.PolicyEngine, actor, manifest); err != nil {
+		return err
+	}
+
+	fmt.Print("\nEnter wallet password: ")
+	passwordBytes, err := term.ReadPassword(int(os.Stdin.Fd()))
+	if err != nil {
+		return fmt.Errorf("failed to read password: %w", err)
+	}
+	fmt.Println()
+
+	results, err := application.AgentService.RegisterAgentsFromManifest(manifest, string(passwordBytes))
+	if err != nil {
+		return fmt.Errorf("failed to register agents: %w", err)
+	}
+
+	successStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")).Bold(true)
+	errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF5555")).Bold(true)
+	labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#888888"))
+	valueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFFFF"))
+
+	fmt.Println()
+	var failed int
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+			fmt.Printf("%s %s: %s\n", errorStyle.Render("✗"), valueStyle.Render(r.Name), r.Err)
+			continue
+		}
+		fmt.Printf("%s %s %s %s\n",
+			successStyle.Render("✓"),
+			valueStyle.Render(r.Name),
+			labelStyle.Render("PDA:"),
+			valueStyle.Render(r.Agent.PDA),
+		)
+	}
+
+	fmt.Println()
+	fmt.Printf("%s %d succeeded, %d failed\n", labelStyle.Render("Summary:"), len(results)-failed, failed)
+	fmt.Println()
+
+	return nil
+}
+
 var agentListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List your agents",
 	Long:  `Display all agents owned by your active wallet.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		agents, err := application.AgentService.ListAgents()
+		if flagListFormat == services.ExportFormatJSON || flagListFormat == services.ExportFormatCSV {
+			w, closeFn, err := openExportWriter(flagListOutput)
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+
+			if err := agentServicer().ExportAgents(w, flagListFormat); err != nil {
+				return fmt.Errorf("failed to export agents: %w", err)
+			}
+			return nil
+		}
+
+		agents, err := agentServicer().ListAgents()
 		if err != nil {
 			return fmt.Errorf("failed to list agents: %w", err)
 		}
@@ -167,7 +272,7 @@ var agentGetCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		agentID := args[0]
 
-		agent, err := application.AgentService.GetAgent(agentID)
+		agent, err := agentServicer().GetAgent(agentID)
 		if err != nil {
 			return fmt.Errorf("failed to get agent: %w", err)
 		}
@@ -211,7 +316,20 @@ var agentAnalyticsCmd = &cobra.Command{
 	Short: "View agent analytics",
 	Long:  `Display aggregated analytics for all your agents.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		analytics, err := application.AgentService.GetAnalytics()
+		if flagAnalyticsFormat == services.ExportFormatJSON || flagAnalyticsFormat == services.ExportFormatCSV {
+			w, closeFn, err := openExportWriter(flagAnalyticsOutput)
+			if err != nil {
+				return err
+			}
+			defer closeFn()
+
+			if err := agentServicer().ExportAnalytics(w, flagAnalyticsFormat); err != nil {
+				return fmt.Errorf("failed to export analytics: %w", err)
+			}
+			return nil
+		}
+
+		analytics, err := agentServicer().GetAnalytics()
 		if err != nil {
 			return fmt.Errorf("failed to get analytics: %w", err)
 		}
@@ -249,6 +367,14 @@ var agentAnalyticsCmd = &cobra.Command{
 }
 
 func init() {
+	agentRegisterCmd.Flags().StringVar(&flagAgentManifest, "manifest", "", "Register agents in bulk from a YAML/JSON manifest file")
+
+	agentListCmd.Flags().StringVar(&flagListFormat, "format", services.ExportFormatTable, "Output format: table, json, csv")
+	agentListCmd.Flags().StringVar(&flagListOutput, "output", "", "Write output to this file instead of stdout")
+
+	agentAnalyticsCmd.Flags().StringVar(&flagAnalyticsFormat, "format", services.ExportFormatTable, "Output format: table, json, csv")
+	agentAnalyticsCmd.Flags().StringVar(&flagAnalyticsOutput, "output", "", "Write output to this file instead of stdout")
+
 	// Add subcommands
 	agentCmd.AddCommand(agentRegisterCmd)
 	agentCmd.AddCommand(agentListCmd)
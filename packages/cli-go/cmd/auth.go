@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ghostspeak/cli-go/internal/services"
+	"github.com/spf13/cobra"
+)
+
+var flagTokenRole string
+var flagTokenOutput string
+var flagTokenActor string
+var flagRevokeJTI string
+
+var authCmd = &cobra.Command{
+	Use:   "auth",
+	Short: "Manage role-scoped API tokens",
+	Long:  `Issue and revoke the role-scoped tokens consumed by 'ghost serve' and '--remote'.`,
+}
+
+var authCreateTokenCmd = &cobra.Command{
+	Use:   "create-token",
+	Short: "Issue a role-scoped token",
+	Long: `Issue a signed token for use with 'ghost --remote <url> --token <file>'.
+
+Roles:
+  reader  can list/get agents and view analytics
+  writer  adds agent registration, but never touches wallets
+  admin   can additionally rotate keys and issue new tokens
+
+--actor sets the identity 'ghost serve' evaluates access policies against (typically the
+wallet public key the token stands in for); it defaults to the caller's active wallet.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if application == nil {
+			return fmt.Errorf("token management is not supported in --remote mode")
+		}
+
+		role := services.Role(flagTokenRole)
+		if !role.Valid() {
+			return fmt.Errorf("invalid role %q, must be one of: reader, writer, admin", flagTokenRole)
+		}
+
+		actor := flagTokenActor
+		if actor == "" {
+			if wallet, err := application.WalletService.GetActiveWallet(); err == nil {
+				actor = wallet.PublicKey
+			}
+		}
+
+		token, err := application.AuthService.CreateToken(role, actor)
+		if err != nil {
+			return fmt.Errorf("failed to create token: %w", err)
+		}
+
+		if flagTokenOutput == "" {
+			fmt.Println(token)
+			return nil
+		}
+
+		if err := os.WriteFile(flagTokenOutput, []byte(token+"\n"), 0600); err != nil {
+			return fmt.Errorf("failed to write token file: %w", err)
+		}
+
+		successStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")).Bold(true)
+		fmt.Println(successStyle.Render(fmt.Sprintf("✓ Wrote %s token to %s", role, flagTokenOutput)))
+
+		return nil
+	},
+}
+
+var authRevokeTokenCmd = &cobra.Command{
+	Use:   "revoke-token",
+	Short: "Revoke a previously issued token",
+	Long: `Revoke a token by its jti (printed when the token was created) so it is
+rejected on every future request even though it hasn't expired.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if application == nil {
+			return fmt.Errorf("token management is not supported in --remote mode")
+		}
+
+		if err := application.AuthService.RevokeToken(flagRevokeJTI); err != nil {
+			return fmt.Errorf("failed to revoke token: %w", err)
+		}
+
+		successStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")).Bold(true)
+		fmt.Println(successStyle.Render(fmt.Sprintf("✓ Revoked token %s", flagRevokeJTI)))
+
+		return nil
+	},
+}
+
+func init() {
+	authCreateTokenCmd.Flags().StringVar(&flagTokenRole, "role", "", "Token role: reader, writer, admin (required)")
+	authCreateTokenCmd.Flags().StringVar(&flagTokenOutput, "output", "", "Write the token to this file instead of stdout")
+	authCreateTokenCmd.Flags().StringVar(&flagTokenActor, "actor", "", "Identity to evaluate access policies against (defaults to the active wallet)")
+	authCreateTokenCmd.MarkFlagRequired("role")
+
+	authRevokeTokenCmd.Flags().StringVar(&flagRevokeJTI, "jti", "", "jti of the token to revoke (required)")
+	authRevokeTokenCmd.MarkFlagRequired("jti")
+
+	authCmd.AddCommand(authCreateTokenCmd)
+	authCmd.AddCommand(authRevokeTokenCmd)
+	rootCmd.AddCommand(authCmd)
+}
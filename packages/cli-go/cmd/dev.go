@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ghostspeak/cli-go/internal/conformance"
+	"github.com/spf13/cobra"
+)
+
+var flagConformanceVectorsDir string
+var flagConformanceUpdate bool
+
+var devCmd = &cobra.Command{
+	Use:    "dev",
+	Short:  "Developer tooling for working on the CLI itself",
+	Hidden: true,
+}
+
+var devConformanceCmd = &cobra.Command{
+	Use:   "conformance",
+	Short: "Run the on-chain agent parsing conformance vectors",
+	Long: `Replay itests/vectors/ through solClient.ParseAgentAccount and
+solClient.DeriveAgentPDA and assert the output is byte-identical to each vector's
+expected.json. Use --update to regenerate expected.json from the live parse after an
+intentional account layout or PDA seed change.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		results, err := conformance.Run(flagConformanceVectorsDir, flagConformanceUpdate)
+		if err != nil {
+			return fmt.Errorf("failed to run conformance vectors: %w", err)
+		}
+
+		successStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")).Bold(true)
+		errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF5555")).Bold(true)
+
+		var failed int
+		for _, r := range results {
+			if r.Passed {
+				fmt.Printf("%s %s\n", successStyle.Render("✓"), r.Name)
+				continue
+			}
+			failed++
+			fmt.Printf("%s %s\n%s\n", errorStyle.Render("✗"), r.Name, r.Mismatch)
+		}
+
+		if failed > 0 {
+			return fmt.Errorf("%d/%d conformance vectors failed", failed, len(results))
+		}
+
+		fmt.Printf("\n%s %d/%d vectors passed\n", successStyle.Render("✓"), len(results), len(results))
+
+		return nil
+	},
+}
+
+func init() {
+	devConformanceCmd.Flags().StringVar(&flagConformanceVectorsDir, "vectors", "itests/vectors", "Path to the conformance vector corpus")
+	devConformanceCmd.Flags().BoolVar(&flagConformanceUpdate, "update", false, "Regenerate expected.json from the live parse instead of checking it")
+
+	devCmd.AddCommand(devConformanceCmd)
+	rootCmd.AddCommand(devCmd)
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ghostspeak/cli-go/internal/ape"
+	"github.com/spf13/cobra"
+)
+
+var (
+	flagPolicyAction       string
+	flagPolicyOwner        string
+	flagPolicyType         string
+	flagPolicyCapabilities string
+)
+
+var policyCmd = &cobra.Command{
+	Use:   "policy",
+	Short: "Inspect capability-based access policies",
+	Long:  `Inspect the access policies loaded from ~/.ghostspeak/policies/*.yaml.`,
+}
+
+var policyTestCmd = &cobra.Command{
+	Use:   "test",
+	Short: "Evaluate a hypothetical request against the loaded policies",
+	Long: `Evaluate a hypothetical Request{Actor, Resource, Action} against the loaded
+policies and print the resulting decision, without performing any real operation.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if application == nil {
+			return fmt.Errorf("policy inspection is not supported in --remote mode")
+		}
+
+		if flagPolicyAction == "" {
+			return fmt.Errorf("--action is required")
+		}
+
+		var capabilities []string
+		if flagPolicyCapabilities != "" {
+			for _, c := range strings.Split(flagPolicyCapabilities, ",") {
+				capabilities = append(capabilities, strings.TrimSpace(c))
+			}
+		}
+
+		req := ape.Request{
+			Actor: flagPolicyOwner,
+			Resource: ape.AgentResource{
+				Owner:        flagPolicyOwner,
+				Type:         flagPolicyType,
+				Capabilities: capabilities,
+			},
+			Action: ape.Action(flagPolicyAction),
+		}
+
+		decision := application.PolicyEngine.Evaluate(req)
+
+		labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#888888"))
+		valueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFFFF"))
+		resultStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF5555")).Bold(true)
+		if decision.Allowed {
+			resultStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")).Bold(true)
+		}
+
+		verdict := "DENY"
+		if decision.Allowed {
+			verdict = "ALLOW"
+		}
+
+		fmt.Println()
+		fmt.Printf("%s %s\n", labelStyle.Render("Verdict:"), resultStyle.Render(verdict))
+		fmt.Printf("%s %s\n", labelStyle.Render("Reason:"), valueStyle.Render(decision.Reason))
+		fmt.Println()
+
+		return nil
+	},
+}
+
+func init() {
+	policyTestCmd.Flags().StringVar(&flagPolicyAction, "action", "", "Action to evaluate, e.g. agent.register")
+	policyTestCmd.Flags().StringVar(&flagPolicyOwner, "owner", "", "Owner pubkey to evaluate as")
+	policyTestCmd.Flags().StringVar(&flagPolicyType, "type", "", "Agent type to evaluate, e.g. content_gen")
+	policyTestCmd.Flags().StringVar(&flagPolicyCapabilities, "capabilities", "", "Comma-separated capabilities to evaluate")
+
+	policyCmd.AddCommand(policyTestCmd)
+	rootCmd.AddCommand(policyCmd)
+}
@@ -3,10 +3,14 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/ghostspeak/cli-go/internal/ape"
 	"github.com/ghostspeak/cli-go/internal/app"
+	"github.com/ghostspeak/cli-go/internal/client"
 	"github.com/ghostspeak/cli-go/internal/config"
+	"github.com/ghostspeak/cli-go/internal/ports"
 	"github.com/spf13/cobra"
 )
 
@@ -16,15 +20,38 @@ var (
 	flagDebug       bool
 	flagDryRun      bool
 	flagNetwork     string
+	flagWorkspace   string
+	flagRemote      string
+	flagToken       string
 
 	// Global app instance
 	application *app.App
 
+	// remoteAgentClient is set instead of application when --remote/--token target a
+	// `ghost serve` daemon rather than the local application.
+	remoteAgentClient ports.AgentServicer
+
 	// Version information
 	Version = "1.0.0"
 	SDKVersion = "2.0.4"
 )
 
+// agentServicer returns whichever AgentServicer backs the `ghost agent` commands for
+// this invocation: a remote daemon if --remote/--token were set, otherwise the local
+// AgentService wrapped with the capability-based access policy engine.
+func agentServicer() ports.AgentServicer {
+	if remoteAgentClient != nil {
+		return remoteAgentClient
+	}
+
+	actor := ""
+	if wallet, err := application.WalletService.GetActiveWallet(); err == nil {
+		actor = wallet.PublicKey
+	}
+
+	return ape.NewGuardedAgentService(application.AgentService, application.PolicyEngine, actor)
+}
+
 // rootCmd represents the base command when called without any subcommands
 var rootCmd = &cobra.Command{
 	Use:   "ghost",
@@ -37,9 +64,23 @@ var rootCmd = &cobra.Command{
 			return nil
 		}
 
+		// --remote/--token dispatch agent commands to a `ghost serve` daemon instead of
+		// initializing the local application.
+		if flagRemote != "" {
+			if flagToken == "" {
+				return fmt.Errorf("--token is required when --remote is set")
+			}
+			tokenBytes, err := os.ReadFile(flagToken)
+			if err != nil {
+				return fmt.Errorf("failed to read token file: %w", err)
+			}
+			remoteAgentClient = client.NewRemoteAgentClient(flagRemote, strings.TrimSpace(string(tokenBytes)))
+			return nil
+		}
+
 		// Initialize application
 		var err error
-		application, err = app.NewApp()
+		application, err = app.NewApp(flagWorkspace)
 		if err != nil {
 			return fmt.Errorf("failed to initialize application: %w", err)
 		}
@@ -75,6 +116,9 @@ func init() {
 	rootCmd.PersistentFlags().BoolVar(&flagDebug, "debug", false, "Enable debug output")
 	rootCmd.PersistentFlags().BoolVar(&flagDryRun, "dry-run", false, "Show what would be done without executing")
 	rootCmd.PersistentFlags().StringVar(&flagNetwork, "network", "", "Override network (devnet, testnet, mainnet)")
+	rootCmd.PersistentFlags().StringVar(&flagWorkspace, "workspace", "", "Override the active workspace for this invocation")
+	rootCmd.PersistentFlags().StringVar(&flagRemote, "remote", "", "Dispatch agent commands to a `ghost serve` daemon at this URL")
+	rootCmd.PersistentFlags().StringVar(&flagToken, "token", "", "Path to a role-scoped token file, required with --remote")
 
 	// Add version command
 	rootCmd.AddCommand(&cobra.Command{
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/ghostspeak/cli-go/internal/config"
+	"github.com/ghostspeak/cli-go/internal/server"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var flagServeAddr string
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Run a daemon exposing AgentService over HTTP",
+	Long: `Expose AgentService over an authenticated HTTP API so CI pipelines and shared
+team infrastructure can register/list agents without distributing wallet passwords.
+
+Clients authenticate with a role-scoped token minted by 'ghost auth create-token' and
+target this daemon with 'ghost --remote <url> --token <file>'.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if application == nil {
+			return fmt.Errorf("ghost serve is not supported in --remote mode")
+		}
+
+		fmt.Print("Enter wallet password to unlock for writer-role requests: ")
+		passwordBytes, err := term.ReadPassword(int(os.Stdin.Fd()))
+		if err != nil {
+			return fmt.Errorf("failed to read password: %w", err)
+		}
+		fmt.Println()
+
+		srv := server.NewServer(application.AgentService, application.PolicyEngine, application.AuthService, string(passwordBytes))
+
+		config.Infof("ghost serve listening on %s", flagServeAddr)
+		fmt.Printf("Listening on %s\n", flagServeAddr)
+
+		return http.ListenAndServe(flagServeAddr, srv)
+	},
+}
+
+func init() {
+	serveCmd.Flags().StringVar(&flagServeAddr, "addr", ":8420", "Address to listen on")
+
+	rootCmd.AddCommand(serveCmd)
+}
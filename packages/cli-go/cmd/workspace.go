@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/spf13/cobra"
+)
+
+var workspaceCmd = &cobra.Command{
+	Use:   "workspace",
+	Short: "Manage workspaces",
+	Long: `Manage isolated workspaces (e.g. "personal", "client-acme", "devnet-sandbox").
+
+Each workspace keeps its own cache namespace, so agents registered in one
+workspace never show up when listing another. Wallets and IPFS pinning
+credentials are still shared across workspaces.`,
+}
+
+var workspaceCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create a new workspace",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if application == nil {
+			return fmt.Errorf("workspace management is not supported in --remote mode")
+		}
+
+		workspace, err := application.WorkspaceService.CreateWorkspace(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to create workspace: %w", err)
+		}
+
+		successStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")).Bold(true)
+		fmt.Println(successStyle.Render(fmt.Sprintf("✓ Created workspace %q", workspace.Name)))
+
+		return nil
+	},
+}
+
+var workspaceListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List workspaces",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if application == nil {
+			return fmt.Errorf("workspace management is not supported in --remote mode")
+		}
+
+		workspaces, err := application.WorkspaceService.ListWorkspaces()
+		if err != nil {
+			return fmt.Errorf("failed to list workspaces: %w", err)
+		}
+
+		active := application.WorkspaceService.Active()
+		labelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#888888"))
+		valueStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#FFFFFF"))
+		activeStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")).Bold(true)
+
+		fmt.Println()
+		for _, w := range workspaces {
+			marker := " "
+			name := valueStyle.Render(w.Name)
+			if w.Name == active {
+				marker = "*"
+				name = activeStyle.Render(w.Name)
+			}
+			fmt.Printf("%s %s %s\n", labelStyle.Render(marker), name, labelStyle.Render(w.CreatedAt.Format("2006-01-02")))
+		}
+		fmt.Println()
+
+		return nil
+	},
+}
+
+var workspaceSwitchCmd = &cobra.Command{
+	Use:   "switch <name>",
+	Short: "Switch the active workspace",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if application == nil {
+			return fmt.Errorf("workspace management is not supported in --remote mode")
+		}
+
+		if err := application.WorkspaceService.SwitchWorkspace(args[0]); err != nil {
+			return fmt.Errorf("failed to switch workspace: %w", err)
+		}
+
+		successStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")).Bold(true)
+		fmt.Println(successStyle.Render(fmt.Sprintf("✓ Switched to workspace %q", args[0])))
+
+		return nil
+	},
+}
+
+var workspaceDeleteCmd = &cobra.Command{
+	Use:   "delete <name>",
+	Short: "Delete a workspace",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if application == nil {
+			return fmt.Errorf("workspace management is not supported in --remote mode")
+		}
+
+		if err := application.WorkspaceService.DeleteWorkspace(args[0]); err != nil {
+			return fmt.Errorf("failed to delete workspace: %w", err)
+		}
+
+		successStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#00FF00")).Bold(true)
+		fmt.Println(successStyle.Render(fmt.Sprintf("✓ Deleted workspace %q", args[0])))
+
+		return nil
+	},
+}
+
+func init() {
+	workspaceCmd.AddCommand(workspaceCreateCmd)
+	workspaceCmd.AddCommand(workspaceListCmd)
+	workspaceCmd.AddCommand(workspaceSwitchCmd)
+	workspaceCmd.AddCommand(workspaceDeleteCmd)
+
+	rootCmd.AddCommand(workspaceCmd)
+}
@@ -0,0 +1,227 @@
+// Package ape implements a capability-based Access Policy Engine that wraps
+// AgentService calls: every call becomes a Request{Actor, Resource, Action} evaluated
+// against YAML allow/deny rules, mirroring the APE model where verbs on a resource are
+// governed independently of the service code that implements them.
+package ape
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Action names the operation being attempted. These line up one-to-one with the
+// AgentService methods a GuardedAgentService wraps.
+type Action string
+
+const (
+	ActionAgentRegister  Action = "agent.register"
+	ActionAgentRead      Action = "agent.read"
+	ActionAgentList      Action = "agent.list"
+	ActionAgentAnalytics Action = "agent.analytics"
+)
+
+// Effect is the verdict a matching Rule produces.
+type Effect string
+
+const (
+	EffectAllow Effect = "allow"
+	EffectDeny  Effect = "deny"
+)
+
+// AgentResource is the target of a Request: the agent being registered, or the set of
+// agents owned by Actor for read-style actions.
+type AgentResource struct {
+	Owner        string
+	Type         string
+	Capabilities []string
+}
+
+// Request describes a single attempted AgentService operation.
+type Request struct {
+	Actor    string
+	Resource AgentResource
+	Action   Action
+}
+
+// Rule is one allow/deny line in a policy file. Action/Owner/Type of "" or "*" match
+// anything; Capability is matched against every capability on the resource using shell
+// glob semantics (e.g. "*nuclear*").
+type Rule struct {
+	Effect     Effect `yaml:"effect"`
+	Action     string `yaml:"action"`
+	Owner      string `yaml:"owner,omitempty"`
+	Type       string `yaml:"type,omitempty"`
+	Capability string `yaml:"capability,omitempty"`
+}
+
+func (r Rule) matches(req Request) bool {
+	if r.Action != "" && r.Action != "*" && r.Action != string(req.Action) {
+		return false
+	}
+	if r.Owner != "" && r.Owner != "*" && r.Owner != req.Resource.Owner {
+		return false
+	}
+	if r.Type != "" && r.Type != "*" && r.Type != req.Resource.Type {
+		return false
+	}
+	if r.Capability != "" {
+		var matched bool
+		for _, c := range req.Resource.Capabilities {
+			if globMatch(r.Capability, c) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// globMatch reports whether value matches pattern, where "*" in pattern matches any run
+// of characters, including zero, with no special treatment of "/" (e.g. "*nuc*lear*"
+// matches "api/nuclear/v2"). Implemented directly rather than via filepath.Match, whose
+// "*" never crosses a path separator and would silently under-match capability strings
+// that happen to contain one.
+func globMatch(pattern, value string) bool {
+	if !strings.Contains(pattern, "*") {
+		return pattern == value
+	}
+
+	parts := strings.Split(pattern, "*")
+
+	if parts[0] != "" && !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	pos := len(parts[0])
+
+	for _, part := range parts[1 : len(parts)-1] {
+		if part == "" {
+			continue
+		}
+		idx := strings.Index(value[pos:], part)
+		if idx == -1 {
+			return false
+		}
+		pos += idx + len(part)
+	}
+
+	last := parts[len(parts)-1]
+	return last == "" || strings.HasSuffix(value[pos:], last)
+}
+
+// Policy is one YAML document under ~/.ghostspeak/policies/.
+type Policy struct {
+	Name  string `yaml:"name"`
+	Rules []Rule `yaml:"rules"`
+}
+
+// Decision is the structured result of evaluating a Request.
+type Decision struct {
+	Allowed bool
+	Reason  string
+	Policy  string
+}
+
+// Engine evaluates Requests against a set of Policies. Rules are scanned in order
+// across all policies; the first matching deny wins outright, otherwise the first
+// matching allow wins, otherwise the request is allowed by default (see Evaluate).
+type Engine struct {
+	policies []Policy
+}
+
+// NewEngine builds an Engine from already-loaded policies.
+func NewEngine(policies []Policy) *Engine {
+	return &Engine{policies: policies}
+}
+
+// Evaluate runs req through every rule in policy order, with explicit deny precedence.
+// Absent any matching rule the request is allowed by default: policies exist to carve
+// out exceptions (e.g. "deny ContentGen agents with a nuclear capability"), not to
+// require every action be explicitly whitelisted.
+func (e *Engine) Evaluate(req Request) Decision {
+	var firstAllow *Decision
+
+	for _, policy := range e.policies {
+		for _, rule := range policy.Rules {
+			if !rule.matches(req) {
+				continue
+			}
+
+			switch rule.Effect {
+			case EffectDeny:
+				return Decision{
+					Allowed: false,
+					Reason:  fmt.Sprintf("denied by policy %q (action=%s)", policy.Name, rule.Action),
+					Policy:  policy.Name,
+				}
+			case EffectAllow:
+				if firstAllow == nil {
+					firstAllow = &Decision{
+						Allowed: true,
+						Reason:  fmt.Sprintf("allowed by policy %q (action=%s)", policy.Name, rule.Action),
+						Policy:  policy.Name,
+					}
+				}
+			}
+		}
+	}
+
+	if firstAllow != nil {
+		return *firstAllow
+	}
+
+	return Decision{Allowed: true, Reason: "no matching rule, default allow"}
+}
+
+// LoadPoliciesFromDir reads every *.yaml file in dir as a Policy. A missing directory is
+// not an error: it simply yields no policies, so the engine falls back to Evaluate's
+// default-allow behavior rather than failing CLI startup. Operators who need a fail-closed
+// posture must ship an explicit deny-all policy rather than relying on an absent directory.
+func LoadPoliciesFromDir(dir string) ([]Policy, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read policy directory: %w", err)
+	}
+
+	var policies []Policy
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".yaml") {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read policy %s: %w", entry.Name(), err)
+		}
+
+		var policy Policy
+		if err := yaml.Unmarshal(data, &policy); err != nil {
+			return nil, fmt.Errorf("failed to parse policy %s: %w", entry.Name(), err)
+		}
+		if policy.Name == "" {
+			policy.Name = strings.TrimSuffix(entry.Name(), ".yaml")
+		}
+
+		policies = append(policies, policy)
+	}
+
+	return policies, nil
+}
+
+// DefaultPoliciesDir returns ~/.ghostspeak/policies, the directory NewApp loads from.
+func DefaultPoliciesDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve home directory: %w", err)
+	}
+	return filepath.Join(home, ".ghostspeak", "policies"), nil
+}
@@ -0,0 +1,110 @@
+package ape
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEvaluateDenyPrecedence(t *testing.T) {
+	engine := NewEngine([]Policy{
+		{
+			Name: "allow-all",
+			Rules: []Rule{
+				{Effect: EffectAllow, Action: "*"},
+			},
+		},
+		{
+			Name: "deny-nuclear",
+			Rules: []Rule{
+				{Effect: EffectDeny, Action: string(ActionAgentRegister), Capability: "*nuclear*"},
+			},
+		},
+	})
+
+	denied := engine.Evaluate(Request{
+		Actor:    "actor-1",
+		Action:   ActionAgentRegister,
+		Resource: AgentResource{Capabilities: []string{"chat", "pre-nuclear-post"}},
+	})
+	if denied.Allowed {
+		t.Fatalf("expected deny-nuclear to win over allow-all, got %+v", denied)
+	}
+
+	allowed := engine.Evaluate(Request{
+		Actor:    "actor-1",
+		Action:   ActionAgentRegister,
+		Resource: AgentResource{Capabilities: []string{"chat"}},
+	})
+	if !allowed.Allowed {
+		t.Fatalf("expected allow-all to apply when no deny rule matches, got %+v", allowed)
+	}
+}
+
+func TestEvaluateDefaultAllowWithNoPolicies(t *testing.T) {
+	engine := NewEngine(nil)
+
+	decision := engine.Evaluate(Request{Actor: "actor-1", Action: ActionAgentRegister})
+	if !decision.Allowed {
+		t.Fatalf("expected default-allow with no policies loaded, got %+v", decision)
+	}
+}
+
+func TestGlobMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		value   string
+		want    bool
+	}{
+		{"exact match", "nlp", "nlp", true},
+		{"exact mismatch", "nlp", "code_gen", false},
+		{"single wildcard match", "*nuclear*", "pre-nuclear-post", true},
+		{"single wildcard mismatch", "*nuclear*", "pre-solar-post", false},
+		{"wildcard-only matches anything", "*", "anything", true},
+		// Regression: filepath.Match's "*" never crosses "/", so a naive
+		// implementation built on it (or on trimming only the outer "*"s) fails this.
+		{"multi-wildcard crosses path separator", "*nuc*lear*", "api/nuclear/v2", true},
+		{"multi-wildcard no match", "*nuc*lear*", "api/solar/v2", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := globMatch(tt.pattern, tt.value); got != tt.want {
+				t.Errorf("globMatch(%q, %q) = %v, want %v", tt.pattern, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadPoliciesFromDirMissingDirReturnsNoPolicies(t *testing.T) {
+	policies, err := LoadPoliciesFromDir(filepath.Join(t.TempDir(), "does-not-exist"))
+	if err != nil {
+		t.Fatalf("expected no error for a missing policy directory, got %v", err)
+	}
+	if policies != nil {
+		t.Fatalf("expected no policies for a missing directory, got %+v", policies)
+	}
+}
+
+func TestLoadPoliciesFromDirParsesYAML(t *testing.T) {
+	dir := t.TempDir()
+	contents := "name: deny-nuclear\nrules:\n  - effect: deny\n    action: agent.register\n    capability: \"*nuclear*\"\n"
+	if err := os.WriteFile(filepath.Join(dir, "deny-nuclear.yaml"), []byte(contents), 0644); err != nil {
+		t.Fatalf("failed to write fixture policy: %v", err)
+	}
+
+	policies, err := LoadPoliciesFromDir(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(policies) != 1 {
+		t.Fatalf("expected 1 policy, got %d", len(policies))
+	}
+	if policies[0].Name != "deny-nuclear" {
+		t.Errorf("expected policy name %q, got %q", "deny-nuclear", policies[0].Name)
+	}
+	if len(policies[0].Rules) != 1 || policies[0].Rules[0].Effect != EffectDeny {
+		t.Errorf("expected a single deny rule, got %+v", policies[0].Rules)
+	}
+}
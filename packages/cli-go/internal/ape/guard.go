@@ -0,0 +1,82 @@
+package ape
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/ghostspeak/cli-go/internal/domain"
+	"github.com/ghostspeak/cli-go/internal/ports"
+)
+
+// GuardedAgentService wraps a ports.AgentServicer so every call is checked against an
+// Engine before being delegated, without the underlying service needing to know
+// anything about policies.
+type GuardedAgentService struct {
+	inner  ports.AgentServicer
+	engine *Engine
+	actor  string
+}
+
+// NewGuardedAgentService wraps inner, evaluating every call as though made by actor
+// (typically the active wallet's public key).
+func NewGuardedAgentService(inner ports.AgentServicer, engine *Engine, actor string) *GuardedAgentService {
+	return &GuardedAgentService{inner: inner, engine: engine, actor: actor}
+}
+
+func (g *GuardedAgentService) authorize(action Action, resource AgentResource) error {
+	decision := g.engine.Evaluate(Request{Actor: g.actor, Resource: resource, Action: action})
+	if !decision.Allowed {
+		return fmt.Errorf("policy denied %s: %s", action, decision.Reason)
+	}
+	return nil
+}
+
+// RegisterAgent authorizes agent.register against the agent's own type/capabilities
+// before delegating.
+func (g *GuardedAgentService) RegisterAgent(params domain.RegisterAgentParams, walletPassword string) (*domain.Agent, error) {
+	resource := AgentResource{Owner: g.actor, Type: params.AgentType.String(), Capabilities: params.Capabilities}
+	if err := g.authorize(ActionAgentRegister, resource); err != nil {
+		return nil, err
+	}
+	return g.inner.RegisterAgent(params, walletPassword)
+}
+
+// ListAgents authorizes agent.list for the actor's own agents before delegating.
+func (g *GuardedAgentService) ListAgents() ([]*domain.Agent, error) {
+	if err := g.authorize(ActionAgentList, AgentResource{Owner: g.actor}); err != nil {
+		return nil, err
+	}
+	return g.inner.ListAgents()
+}
+
+// GetAgent authorizes agent.read before delegating.
+func (g *GuardedAgentService) GetAgent(agentID string) (*domain.Agent, error) {
+	if err := g.authorize(ActionAgentRead, AgentResource{Owner: g.actor}); err != nil {
+		return nil, err
+	}
+	return g.inner.GetAgent(agentID)
+}
+
+// GetAnalytics authorizes agent.analytics before delegating.
+func (g *GuardedAgentService) GetAnalytics() (*domain.Analytics, error) {
+	if err := g.authorize(ActionAgentAnalytics, AgentResource{Owner: g.actor}); err != nil {
+		return nil, err
+	}
+	return g.inner.GetAnalytics()
+}
+
+// ExportAgents authorizes agent.list before delegating, matching ListAgents.
+func (g *GuardedAgentService) ExportAgents(w io.Writer, format string) error {
+	if err := g.authorize(ActionAgentList, AgentResource{Owner: g.actor}); err != nil {
+		return err
+	}
+	return g.inner.ExportAgents(w, format)
+}
+
+// ExportAnalytics authorizes agent.analytics before delegating, matching GetAnalytics.
+func (g *GuardedAgentService) ExportAnalytics(w io.Writer, format string) error {
+	if err := g.authorize(ActionAgentAnalytics, AgentResource{Owner: g.actor}); err != nil {
+		return err
+	}
+	return g.inner.ExportAnalytics(w, format)
+}
@@ -0,0 +1,35 @@
+package ape
+
+import (
+	"fmt"
+
+	"github.com/ghostspeak/cli-go/internal/services"
+)
+
+// AuthorizeManifest evaluates ActionAgentRegister for every entry in manifest as though
+// made by actor, failing on the first denial. It exists because RegisterAgentsFromManifest
+// is not part of ports.AgentServicer (ports cannot import services, so GuardedAgentService
+// cannot wrap it directly) yet manifest-based registration must be gated by the same
+// policies as a single RegisterAgent call. Each entry is authorized against the same
+// canonicalized AgentType.String() that GuardedAgentService.RegisterAgent uses, so a
+// type-keyed rule can't be routed around by spelling a manifest's "type" differently than
+// its parsed/canonical form.
+func AuthorizeManifest(engine *Engine, actor string, manifest *services.AgentManifest) error {
+	for _, entry := range manifest.Agents {
+		params, err := entry.ToRegisterParams()
+		if err != nil {
+			return err
+		}
+
+		resource := AgentResource{
+			Owner:        actor,
+			Type:         params.AgentType.String(),
+			Capabilities: params.Capabilities,
+		}
+		decision := engine.Evaluate(Request{Actor: actor, Resource: resource, Action: ActionAgentRegister})
+		if !decision.Allowed {
+			return fmt.Errorf("policy denied agent.register for %q: %s", entry.Name, decision.Reason)
+		}
+	}
+	return nil
+}
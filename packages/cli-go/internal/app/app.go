@@ -3,6 +3,7 @@ package app
 import (
 	"fmt"
 
+	"github.com/ghostspeak/cli-go/internal/ape"
 	"github.com/ghostspeak/cli-go/internal/config"
 	"github.com/ghostspeak/cli-go/internal/services"
 	"github.com/ghostspeak/cli-go/internal/storage"
@@ -11,22 +12,31 @@ import (
 
 // App is the main application container
 type App struct {
-	Config        *config.Config
-	SolanaClient  *solana.Client
-	Storage       *storage.BadgerDB
-	WalletService *services.WalletService
-	IPFSService   *services.IPFSService
-	AgentService  *services.AgentService
+	Config           *config.Config
+	SolanaClient     *solana.Client
+	Storage          *storage.BadgerDB
+	WalletService    *services.WalletService
+	IPFSService      *services.IPFSService
+	AgentService     *services.AgentService
+	WorkspaceService *services.WorkspaceService
+	AuthService      *services.AuthService
+	PolicyEngine     *ape.Engine
 }
 
-// NewApp creates and initializes a new application
-func NewApp() (*App, error) {
+// NewApp creates and initializes a new application. workspaceOverride, when non-empty,
+// takes precedence over the workspace persisted in config for the lifetime of this
+// process (the `--workspace` global flag).
+func NewApp(workspaceOverride string) (*App, error) {
 	// Load configuration
 	cfg, err := config.LoadConfig()
 	if err != nil {
 		return nil, fmt.Errorf("failed to load config: %w", err)
 	}
 
+	if workspaceOverride != "" {
+		cfg.Workspace.Active = workspaceOverride
+	}
+
 	// Initialize logger
 	config.InitLogger(cfg)
 	config.Info("GhostSpeak CLI starting...")
@@ -52,20 +62,42 @@ func NewApp() (*App, error) {
 		return nil, fmt.Errorf("failed to initialize storage: %w", err)
 	}
 
+	// Resolve the active workspace before wiring the services that cache against it, so
+	// every service shares a single namespace for the lifetime of this process.
+	workspaceService := services.NewWorkspaceService(cfg, badgerDB)
+	config.Infof("Workspace: %s", workspaceService.Active())
+
 	// Initialize services
 	walletService := services.NewWalletService(cfg, solanaClient)
 	ipfsService := services.NewIPFSService(cfg)
 	agentService := services.NewAgentService(cfg, solanaClient, walletService, ipfsService, badgerDB)
+	authService := services.NewAuthService(cfg, badgerDB)
+
+	// Load capability-based access policies. A missing ~/.ghostspeak/policies directory
+	// just means no policies are enforced yet, not a startup failure.
+	policiesDir, err := ape.DefaultPoliciesDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve policies directory: %w", err)
+	}
+	policies, err := ape.LoadPoliciesFromDir(policiesDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load policies: %w", err)
+	}
+	policyEngine := ape.NewEngine(policies)
+	config.Infof("Loaded %d access polic(ies) from %s", len(policies), policiesDir)
 
 	config.Info("Application initialized successfully")
 
 	return &App{
-		Config:        cfg,
-		SolanaClient:  solanaClient,
-		Storage:       badgerDB,
-		WalletService: walletService,
-		IPFSService:   ipfsService,
-		AgentService:  agentService,
+		Config:           cfg,
+		SolanaClient:     solanaClient,
+		Storage:          badgerDB,
+		WalletService:    walletService,
+		IPFSService:      ipfsService,
+		AgentService:     agentService,
+		WorkspaceService: workspaceService,
+		AuthService:      authService,
+		PolicyEngine:     policyEngine,
 	}, nil
 }
 
@@ -0,0 +1,144 @@
+// Package client implements an AgentServicer that dispatches to a remote `ghost serve`
+// daemon instead of the local application, so the same `ghost agent` command tree works
+// against either target.
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ghostspeak/cli-go/internal/domain"
+	"github.com/ghostspeak/cli-go/internal/services"
+)
+
+// RemoteAgentClient implements ports.AgentServicer by calling a `ghost serve` daemon.
+type RemoteAgentClient struct {
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+// NewRemoteAgentClient creates a client targeting baseURL, authenticating with token.
+func NewRemoteAgentClient(baseURL, token string) *RemoteAgentClient {
+	return &RemoteAgentClient{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+		http:    &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (c *RemoteAgentClient) do(method, path string, body interface{}, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		buf, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reqBody = bytes.NewReader(buf)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach remote daemon: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("remote daemon returned %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// RegisterAgent calls POST /v1/agents/register. walletPassword is ignored: the daemon
+// unlocks its own wallet once at `ghost serve` startup, which is the whole point of
+// writer-scoped tokens never touching wallet secrets.
+func (c *RemoteAgentClient) RegisterAgent(params domain.RegisterAgentParams, walletPassword string) (*domain.Agent, error) {
+	var agent domain.Agent
+	if err := c.do(http.MethodPost, "/v1/agents/register", params, &agent); err != nil {
+		return nil, err
+	}
+	return &agent, nil
+}
+
+// ListAgents calls GET /v1/agents.
+func (c *RemoteAgentClient) ListAgents() ([]*domain.Agent, error) {
+	var agents []*domain.Agent
+	if err := c.do(http.MethodGet, "/v1/agents", nil, &agents); err != nil {
+		return nil, err
+	}
+	return agents, nil
+}
+
+// GetAgent calls GET /v1/agents/{id}.
+func (c *RemoteAgentClient) GetAgent(agentID string) (*domain.Agent, error) {
+	var agent domain.Agent
+	if err := c.do(http.MethodGet, "/v1/agents/"+agentID, nil, &agent); err != nil {
+		return nil, err
+	}
+	return &agent, nil
+}
+
+// GetAnalytics calls GET /v1/analytics.
+func (c *RemoteAgentClient) GetAnalytics() (*domain.Analytics, error) {
+	var analytics domain.Analytics
+	if err := c.do(http.MethodGet, "/v1/analytics", nil, &analytics); err != nil {
+		return nil, err
+	}
+	return &analytics, nil
+}
+
+// ExportAgents fetches the remote agent list and renders it locally, since the daemon
+// only speaks JSON over the wire.
+func (c *RemoteAgentClient) ExportAgents(w io.Writer, format string) error {
+	agents, err := c.ListAgents()
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case services.ExportFormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(agents)
+	case services.ExportFormatCSV:
+		return services.ExportAgentsCSV(w, agents)
+	default:
+		return fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// ExportAnalytics fetches remote analytics and renders them locally.
+func (c *RemoteAgentClient) ExportAnalytics(w io.Writer, format string) error {
+	analytics, err := c.GetAnalytics()
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case services.ExportFormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(analytics)
+	case services.ExportFormatCSV:
+		return services.ExportAnalyticsCSV(w, analytics)
+	default:
+		return fmt.Errorf("unsupported export format: %s", format)
+	}
+}
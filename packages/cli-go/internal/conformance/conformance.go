@@ -0,0 +1,144 @@
+// Package conformance drives the itests/vectors/ corpus: it parses each fixture's raw
+// account bytes and PDA seeds through pkg/solana and asserts the result is byte-identical
+// to the recorded expected.json, so a change to the on-chain account layout or PDA seeds
+// is caught immediately instead of surfacing as a silent parse failure in the field.
+//
+// The corpus covers one vector per domain.AgentType (general/data_analysis/content_gen/
+// automation/research) plus a short-agent_id edge case. It does not vary name or
+// capabilities between vectors: those come from IPFS metadata, not the on-chain account
+// layout this corpus exercises, so they can't catch a layout/PDA regression.
+package conformance
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ghostspeak/cli-go/internal/domain"
+	solClient "github.com/ghostspeak/cli-go/pkg/solana"
+)
+
+// VectorMeta is {name}/meta.json: the inputs needed to reproduce a vector's expected
+// output alongside its raw account data in {name}/input.bin.
+type VectorMeta struct {
+	ProgramID string `json:"program_id"`
+	AgentID   string `json:"agent_id"`
+	Owner     string `json:"owner"`
+}
+
+// ExpectedOutput is {name}/expected.json: the parsed agent plus derived PDA a vector
+// must reproduce exactly.
+type ExpectedOutput struct {
+	Agent *domain.Agent `json:"agent"`
+	PDA   string        `json:"pda"`
+}
+
+// Result is the outcome of running a single vector.
+type Result struct {
+	Name     string
+	Passed   bool
+	Mismatch string
+}
+
+// Run loads every vector under vectorsDir, parses it through solClient, and compares the
+// result to expected.json. When update is true, expected.json is rewritten from the live
+// parse instead of being checked, matching `ghost dev conformance --update`.
+func Run(vectorsDir string, update bool) ([]Result, error) {
+	entries, err := os.ReadDir(vectorsDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vectors directory: %w", err)
+	}
+
+	var results []Result
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		result, err := runVector(filepath.Join(vectorsDir, entry.Name()), entry.Name(), update)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func runVector(dir, name string, update bool) (Result, error) {
+	data, err := os.ReadFile(filepath.Join(dir, "input.bin"))
+	if err != nil {
+		return Result{}, fmt.Errorf("vector %s: failed to read input.bin: %w", name, err)
+	}
+
+	metaBytes, err := os.ReadFile(filepath.Join(dir, "meta.json"))
+	if err != nil {
+		return Result{}, fmt.Errorf("vector %s: failed to read meta.json: %w", name, err)
+	}
+	var meta VectorMeta
+	if err := json.Unmarshal(metaBytes, &meta); err != nil {
+		return Result{}, fmt.Errorf("vector %s: failed to parse meta.json: %w", name, err)
+	}
+
+	programID, err := solClient.PubkeyFromString(meta.ProgramID)
+	if err != nil {
+		return Result{}, fmt.Errorf("vector %s: invalid program_id: %w", name, err)
+	}
+	ownerPubkey, err := solClient.PubkeyFromString(meta.Owner)
+	if err != nil {
+		return Result{}, fmt.Errorf("vector %s: invalid owner: %w", name, err)
+	}
+
+	pda, _, err := solClient.DeriveAgentPDA(programID, meta.AgentID, ownerPubkey)
+	if err != nil {
+		return Result{}, fmt.Errorf("vector %s: DeriveAgentPDA failed: %w", name, err)
+	}
+
+	// Pass the account's own address, matching the only other call site
+	// (services.AgentService.ListAgents), which parses with the PDA/account
+	// pubkey rather than the owner.
+	agent, err := solClient.ParseAgentAccount(data, pda.String())
+	if err != nil {
+		return Result{}, fmt.Errorf("vector %s: ParseAgentAccount failed: %w", name, err)
+	}
+
+	actual := ExpectedOutput{Agent: agent, PDA: pda.String()}
+	actualJSON, err := json.MarshalIndent(actual, "", "  ")
+	if err != nil {
+		return Result{}, fmt.Errorf("vector %s: failed to marshal actual output: %w", name, err)
+	}
+
+	expectedPath := filepath.Join(dir, "expected.json")
+	if update {
+		if err := os.WriteFile(expectedPath, append(actualJSON, '\n'), 0644); err != nil {
+			return Result{}, fmt.Errorf("vector %s: failed to write expected.json: %w", name, err)
+		}
+		return Result{Name: name, Passed: true}, nil
+	}
+
+	expectedBytes, err := os.ReadFile(expectedPath)
+	if err != nil {
+		return Result{}, fmt.Errorf("vector %s: failed to read expected.json: %w", name, err)
+	}
+
+	var expected ExpectedOutput
+	if err := json.Unmarshal(expectedBytes, &expected); err != nil {
+		return Result{}, fmt.Errorf("vector %s: failed to parse expected.json: %w", name, err)
+	}
+	expectedJSON, err := json.MarshalIndent(expected, "", "  ")
+	if err != nil {
+		return Result{}, fmt.Errorf("vector %s: failed to marshal expected output: %w", name, err)
+	}
+
+	if !bytes.Equal(actualJSON, expectedJSON) {
+		return Result{
+			Name:     name,
+			Passed:   false,
+			Mismatch: fmt.Sprintf("got:\n%s\n\nwant:\n%s", actualJSON, expectedJSON),
+		}, nil
+	}
+
+	return Result{Name: name, Passed: true}, nil
+}
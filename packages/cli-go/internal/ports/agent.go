@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"io"
+
+	"github.com/ghostspeak/cli-go/internal/domain"
+)
+
+// AgentServicer is the subset of AgentService that the `ghost agent` commands depend on.
+// Both the local *services.AgentService and the remote daemon client implement it, so the
+// CLI can dispatch to either transparently.
+type AgentServicer interface {
+	RegisterAgent(params domain.RegisterAgentParams, walletPassword string) (*domain.Agent, error)
+	ListAgents() ([]*domain.Agent, error)
+	GetAgent(agentID string) (*domain.Agent, error)
+	GetAnalytics() (*domain.Analytics, error)
+	ExportAgents(w io.Writer, format string) error
+	ExportAnalytics(w io.Writer, format string) error
+}
@@ -0,0 +1,167 @@
+// Package server exposes AgentService over an authenticated HTTP API for `ghost serve`,
+// so CI pipelines and shared team infrastructure can register/list agents without
+// distributing wallet passwords.
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/ghostspeak/cli-go/internal/ape"
+	"github.com/ghostspeak/cli-go/internal/domain"
+	"github.com/ghostspeak/cli-go/internal/ports"
+	"github.com/ghostspeak/cli-go/internal/services"
+)
+
+type actorContextKey struct{}
+
+// Server is the HTTP handler started by `ghost serve`.
+type Server struct {
+	agentService ports.AgentServicer
+	policyEngine *ape.Engine
+	authService  *services.AuthService
+	mux          *http.ServeMux
+
+	// walletPassword unlocks the wallet for writer-role RegisterAgent calls made over
+	// the API, since writer tokens are explicitly not allowed to carry wallet secrets.
+	walletPassword string
+}
+
+// NewServer wires an HTTP handler around agentService and authService. walletPassword is
+// collected once at `ghost serve` startup and reused for every RegisterAgent call made
+// by a writer/admin token. Every request is authorized against policyEngine as the actor
+// named in its bearer token's Subject claim, the same as a local GuardedAgentService call.
+func NewServer(agentService ports.AgentServicer, policyEngine *ape.Engine, authService *services.AuthService, walletPassword string) *Server {
+	s := &Server{
+		agentService:   agentService,
+		policyEngine:   policyEngine,
+		authService:    authService,
+		mux:            http.NewServeMux(),
+		walletPassword: walletPassword,
+	}
+	s.routes()
+	return s
+}
+
+// guardedAgentService returns an AgentServicer that authorizes every call as the actor
+// attached to r's request context by requireRole.
+func (s *Server) guardedAgentService(r *http.Request) ports.AgentServicer {
+	actor, _ := r.Context().Value(actorContextKey{}).(string)
+	return ape.NewGuardedAgentService(s.agentService, s.policyEngine, actor)
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) routes() {
+	s.mux.HandleFunc("/v1/agents", s.requireRole(services.RoleReader, s.handleListAgents))
+	s.mux.HandleFunc("/v1/agents/register", s.requireRole(services.RoleWriter, s.handleRegisterAgent))
+	s.mux.HandleFunc("/v1/agents/", s.requireRole(services.RoleReader, s.handleGetAgent))
+	s.mux.HandleFunc("/v1/analytics", s.requireRole(services.RoleReader, s.handleAnalytics))
+}
+
+// requireRole wraps next so it only runs once the caller's bearer token validates and
+// grants at least minRole.
+func (s *Server) requireRole(minRole services.Role, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		raw := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if raw == "" {
+			http.Error(w, "missing bearer token", http.StatusUnauthorized)
+			return
+		}
+
+		role, actor, err := s.authService.ValidateToken(raw)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if !role.Allows(minRole) {
+			http.Error(w, "insufficient role", http.StatusForbidden)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), actorContextKey{}, actor)
+		next(w, r.WithContext(ctx))
+	}
+}
+
+func (s *Server) handleListAgents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	agents, err := s.guardedAgentService(r).ListAgents()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, agents)
+}
+
+func (s *Server) handleGetAgent(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	agentID := strings.TrimPrefix(r.URL.Path, "/v1/agents/")
+	if agentID == "" {
+		http.Error(w, "agent id required", http.StatusBadRequest)
+		return
+	}
+
+	agent, err := s.guardedAgentService(r).GetAgent(agentID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	writeJSON(w, agent)
+}
+
+func (s *Server) handleRegisterAgent(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var params domain.RegisterAgentParams
+	if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	agent, err := s.guardedAgentService(r).RegisterAgent(params, s.walletPassword)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, agent)
+}
+
+func (s *Server) handleAnalytics(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	analytics, err := s.guardedAgentService(r).GetAnalytics()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, analytics)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
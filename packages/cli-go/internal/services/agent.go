@@ -2,6 +2,7 @@ package services
 
 import (
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/ghostspeak/cli-go/internal/config"
@@ -10,6 +11,11 @@ import (
 	solClient "github.com/ghostspeak/cli-go/pkg/solana"
 )
 
+// manifestRegisterConcurrency bounds how many IPFS uploads / PDA derivations run at once
+// when registering agents from a manifest, so a large batch doesn't hammer the RPC or
+// the pinning service.
+const manifestRegisterConcurrency = 4
+
 // AgentService handles agent operations
 type AgentService struct {
 	cfg           *config.Config
@@ -36,6 +42,44 @@ func NewAgentService(
 	}
 }
 
+// workspace returns the active workspace namespace used to prefix cache keys, so that
+// agents from different workspaces never mix in ListAgents/GetAgent.
+func (s *AgentService) workspace() string {
+	if s.cfg.Workspace.Active == "" {
+		return DefaultWorkspace
+	}
+	return s.cfg.Workspace.Active
+}
+
+// agentWorkspaceIndexKey namespaces the durable (non-expiring) record of which workspace
+// registered a given agent ID. Wallets are shared across workspaces, so the on-chain
+// account alone can't tell two workspaces' agents apart once the short-lived ListAgents
+// cache expires; this index is what lets a fresh on-chain fetch still be filtered
+// per-workspace.
+func agentWorkspaceIndexKey(agentID string) string {
+	return fmt.Sprintf("workspace-owner:%s", agentID)
+}
+
+// recordAgentWorkspace durably associates agentID with the active workspace, so future
+// ListAgents calls in other workspaces can exclude it even after the on-chain fetch runs.
+func (s *AgentService) recordAgentWorkspace(agentID string) {
+	if err := s.storage.SetJSON(agentWorkspaceIndexKey(agentID), s.workspace()); err != nil {
+		config.Warnf("Failed to record workspace for agent %s: %v", agentID, err)
+	}
+}
+
+// belongsToActiveWorkspace reports whether agentID was registered from the active
+// workspace. Agents with no recorded workspace (registered before this index existed)
+// are treated as belonging only to the default workspace, so they don't leak into every
+// workspace that happens to share the same wallet.
+func (s *AgentService) belongsToActiveWorkspace(agentID string) bool {
+	var workspace string
+	if err := s.storage.GetJSON(agentWorkspaceIndexKey(agentID), &workspace); err != nil {
+		return s.workspace() == DefaultWorkspace
+	}
+	return workspace == s.workspace()
+}
+
 // RegisterAgent registers a new agent on the blockchain
 func (s *AgentService) RegisterAgent(params domain.RegisterAgentParams, walletPassword string) (*domain.Agent, error) {
 	// Validate parameters
@@ -118,16 +162,158 @@ func (s *AgentService) RegisterAgent(params domain.RegisterAgentParams, walletPa
 	}
 
 	// Cache agent locally
-	cacheKey := fmt.Sprintf("agent:%s", agentID)
+	cacheKey := fmt.Sprintf("%s:agent:%s", s.workspace(), agentID)
 	if err := s.storage.SetJSONWithTTL(cacheKey, agent, 24*time.Hour); err != nil {
 		config.Warnf("Failed to cache agent: %v", err)
 	}
+	s.recordAgentWorkspace(agentID)
 
 	config.Infof("Agent registered successfully: %s", agentID)
 
 	return agent, nil
 }
 
+// BatchRegisterResult captures the outcome of registering a single manifest entry.
+type BatchRegisterResult struct {
+	Name  string
+	Agent *domain.Agent
+	Err   error
+}
+
+// RegisterAgentsFromManifest registers every agent described in an AgentManifest in a
+// single invocation. The whole batch is validated up front, the wallet is unlocked once,
+// IPFS uploads are pipelined through a bounded worker pool, and PDA derivation plus
+// submission runs with the same bounded concurrency. Per-agent failures are reported in
+// the returned slice rather than aborting the batch.
+func (s *AgentService) RegisterAgentsFromManifest(manifest *AgentManifest, walletPassword string) ([]BatchRegisterResult, error) {
+	paramsList := make([]domain.RegisterAgentParams, len(manifest.Agents))
+	for i, entry := range manifest.Agents {
+		params, err := entry.ToRegisterParams()
+		if err != nil {
+			return nil, err
+		}
+		if err := domain.ValidateRegisterParams(params); err != nil {
+			return nil, fmt.Errorf("agent %q: %w", entry.Name, err)
+		}
+		paramsList[i] = params
+	}
+
+	activeWallet, err := s.walletService.GetActiveWallet()
+	if err != nil {
+		return nil, fmt.Errorf("no active wallet: %w", err)
+	}
+
+	privateKey, err := s.walletService.LoadWallet(activeWallet.Name, walletPassword)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load wallet: %w", err)
+	}
+
+	config.Infof("Registering %d agents from manifest", len(paramsList))
+
+	type uploadResult struct {
+		params      domain.RegisterAgentParams
+		metadataURI string
+		err         error
+	}
+
+	uploads := make([]uploadResult, len(paramsList))
+	sem := make(chan struct{}, manifestRegisterConcurrency)
+	var uploadWG sync.WaitGroup
+
+	for i, params := range paramsList {
+		uploadWG.Add(1)
+		go func(i int, params domain.RegisterAgentParams, entry ManifestAgentEntry) {
+			defer uploadWG.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			metadata := &domain.AgentMetadata{
+				Name:         params.Name,
+				Description:  params.Description,
+				AgentType:    params.AgentType.String(),
+				Capabilities: params.Capabilities,
+				Version:      params.Version,
+				ImageURL:     params.ImageURL,
+				CreatedAt:    time.Now().Format(time.RFC3339),
+			}
+			entry.applyMetadataOverride(metadata)
+
+			metadataURI, err := s.ipfsService.UploadAgentMetadata(metadata)
+			uploads[i] = uploadResult{params: params, metadataURI: metadataURI, err: err}
+		}(i, params, manifest.Agents[i])
+	}
+	uploadWG.Wait()
+
+	ownerPubkey := privateKey.PublicKey()
+	results := make([]BatchRegisterResult, len(uploads))
+	var resultsMu sync.Mutex
+	var submitWG sync.WaitGroup
+
+	for i, up := range uploads {
+		submitWG.Add(1)
+		go func(i int, up uploadResult) {
+			defer submitWG.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			if up.err != nil {
+				resultsMu.Lock()
+				results[i] = BatchRegisterResult{Name: up.params.Name, Err: fmt.Errorf("failed to upload metadata: %w", up.err)}
+				resultsMu.Unlock()
+				return
+			}
+
+			agentID := fmt.Sprintf("agent_%d_%d", time.Now().UnixNano(), i)
+
+			agentPDA, _, err := solClient.DeriveAgentPDA(s.client.GetProgramID(), agentID, ownerPubkey)
+			if err != nil {
+				resultsMu.Lock()
+				results[i] = BatchRegisterResult{Name: up.params.Name, Err: fmt.Errorf("failed to derive PDA: %w", err)}
+				resultsMu.Unlock()
+				return
+			}
+
+			// TODO: Build and send transaction to register agent
+			agent := &domain.Agent{
+				ID:           agentID,
+				Owner:        ownerPubkey.String(),
+				Name:         up.params.Name,
+				AgentType:    up.params.AgentType,
+				MetadataURI:  up.metadataURI,
+				Status:       domain.AgentStatusActive,
+				Description:  up.params.Description,
+				Capabilities: up.params.Capabilities,
+				Version:      up.params.Version,
+				ImageURL:     up.params.ImageURL,
+				PDA:          agentPDA.String(),
+				CreatedAt:    time.Now(),
+				UpdatedAt:    time.Now(),
+			}
+
+			cacheKey := fmt.Sprintf("%s:agent:%s", s.workspace(), agentID)
+			if err := s.storage.SetJSONWithTTL(cacheKey, agent, 24*time.Hour); err != nil {
+				config.Warnf("Failed to cache agent %s: %v", agentID, err)
+			}
+			s.recordAgentWorkspace(agentID)
+
+			resultsMu.Lock()
+			results[i] = BatchRegisterResult{Name: up.params.Name, Agent: agent}
+			resultsMu.Unlock()
+		}(i, up)
+	}
+	submitWG.Wait()
+
+	var failures int
+	for _, r := range results {
+		if r.Err != nil {
+			failures++
+		}
+	}
+	config.Infof("Manifest registration complete: %d succeeded, %d failed", len(results)-failures, failures)
+
+	return results, nil
+}
+
 // ListAgents lists all agents owned by the active wallet
 func (s *AgentService) ListAgents() ([]*domain.Agent, error) {
 	// Get active wallet
@@ -139,7 +325,7 @@ func (s *AgentService) ListAgents() ([]*domain.Agent, error) {
 	config.Infof("Fetching agents for wallet: %s", activeWallet.PublicKey)
 
 	// Check cache first
-	cacheKey := fmt.Sprintf("agents:%s", activeWallet.PublicKey)
+	cacheKey := fmt.Sprintf("%s:agents:%s", s.workspace(), activeWallet.PublicKey)
 	var cachedAgents []*domain.Agent
 	if err := s.storage.GetJSON(cacheKey, &cachedAgents); err == nil && cachedAgents != nil {
 		config.Debug("Using cached agents")
@@ -163,10 +349,15 @@ func (s *AgentService) ListAgents() ([]*domain.Agent, error) {
 			continue
 		}
 
-		// Filter by owner
+		// Filter by owner. Wallets are shared across workspaces, so owner alone isn't
+		// enough once this cache has expired: also filter by the workspace that
+		// registered the agent, using the durable index recorded at registration time.
 		if agent.Owner != activeWallet.PublicKey {
 			continue
 		}
+		if !s.belongsToActiveWorkspace(agent.ID) {
+			continue
+		}
 
 		// Fetch metadata from IPFS
 		if agent.MetadataURI != "" {
@@ -197,7 +388,7 @@ func (s *AgentService) ListAgents() ([]*domain.Agent, error) {
 // GetAgent gets a specific agent by ID
 func (s *AgentService) GetAgent(agentID string) (*domain.Agent, error) {
 	// Check cache first
-	cacheKey := fmt.Sprintf("agent:%s", agentID)
+	cacheKey := fmt.Sprintf("%s:agent:%s", s.workspace(), agentID)
 	var agent domain.Agent
 	if err := s.storage.GetJSON(cacheKey, &agent); err == nil {
 		config.Debug("Using cached agent")
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ghostspeak/cli-go/internal/config"
+	"github.com/ghostspeak/cli-go/internal/ports"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Role gates which AgentService methods a `ghost serve` token is allowed to call.
+// Roles are cumulative: admin implies writer, writer implies reader.
+type Role string
+
+const (
+	RoleReader Role = "reader"
+	RoleWriter Role = "writer"
+	RoleAdmin  Role = "admin"
+)
+
+var roleRank = map[Role]int{RoleReader: 0, RoleWriter: 1, RoleAdmin: 2}
+
+// Allows reports whether r grants the privileges required for action.
+func (r Role) Allows(action Role) bool {
+	rank, ok := roleRank[r]
+	if !ok {
+		return false
+	}
+	return rank >= roleRank[action]
+}
+
+// Valid reports whether r is one of the known roles.
+func (r Role) Valid() bool {
+	_, ok := roleRank[r]
+	return ok
+}
+
+const revokedTokenPrefix = "auth:revoked:"
+
+type tokenClaims struct {
+	Role Role `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// AuthService issues and validates role-scoped JWTs used by `ghost serve` and
+// `ghost auth create-token`. Revocation is tracked in storage rather than relying on
+// short expiries, since CI/service tokens are expected to live indefinitely until rotated.
+type AuthService struct {
+	cfg     *config.Config
+	storage ports.Storage
+}
+
+// NewAuthService creates a new auth service.
+func NewAuthService(cfg *config.Config, storage ports.Storage) *AuthService {
+	return &AuthService{cfg: cfg, storage: storage}
+}
+
+// CreateToken issues a signed JWT scoped to role, acting as actor for policy evaluation
+// (the Subject claim). actor is typically the wallet public key the token stands in for;
+// it is what GuardedAgentService checks requests against on the `ghost serve` side.
+func (s *AuthService) CreateToken(role Role, actor string) (string, error) {
+	if !role.Valid() {
+		return "", fmt.Errorf("unknown role: %s", role)
+	}
+
+	claims := tokenClaims{
+		Role: role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:       fmt.Sprintf("tok_%d", time.Now().UnixNano()),
+			Subject:  actor,
+			IssuedAt: jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString([]byte(s.cfg.Auth.SigningKey))
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	config.Infof("Issued %s token %s", role, claims.ID)
+
+	return signed, nil
+}
+
+// ValidateToken parses and verifies raw, returning its role and actor (Subject claim)
+// unless it has been revoked.
+func (s *AuthService) ValidateToken(raw string) (Role, string, error) {
+	var claims tokenClaims
+	_, err := jwt.ParseWithClaims(raw, &claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return []byte(s.cfg.Auth.SigningKey), nil
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("invalid token: %w", err)
+	}
+
+	var revoked bool
+	if err := s.storage.GetJSON(revokedTokenPrefix+claims.ID, &revoked); err == nil {
+		return "", "", fmt.Errorf("token %s has been revoked", claims.ID)
+	}
+
+	return claims.Role, claims.Subject, nil
+}
+
+// RevokeToken marks a token's jti as revoked so future ValidateToken calls reject it.
+func (s *AuthService) RevokeToken(jti string) error {
+	if err := s.storage.SetJSON(revokedTokenPrefix+jti, true); err != nil {
+		return fmt.Errorf("failed to revoke token: %w", err)
+	}
+
+	config.Infof("Revoked token %s", jti)
+
+	return nil
+}
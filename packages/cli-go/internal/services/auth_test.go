@@ -0,0 +1,123 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ghostspeak/cli-go/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// fakeAuthStorage is a minimal in-memory ports.Storage for exercising AuthService without
+// a real BadgerDB instance.
+type fakeAuthStorage struct {
+	data map[string][]byte
+}
+
+func newFakeAuthStorage() *fakeAuthStorage {
+	return &fakeAuthStorage{data: make(map[string][]byte)}
+}
+
+func (f *fakeAuthStorage) GetJSON(key string, out interface{}) error {
+	raw, ok := f.data[key]
+	if !ok {
+		return fmt.Errorf("key %s not found", key)
+	}
+	return json.Unmarshal(raw, out)
+}
+
+func (f *fakeAuthStorage) SetJSON(key string, v interface{}) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	f.data[key] = raw
+	return nil
+}
+
+func (f *fakeAuthStorage) SetJSONWithTTL(key string, v interface{}, ttl time.Duration) error {
+	return f.SetJSON(key, v)
+}
+
+func newTestAuthService() *AuthService {
+	cfg := &config.Config{}
+	cfg.Auth.SigningKey = "test-signing-key"
+	return NewAuthService(cfg, newFakeAuthStorage())
+}
+
+func TestCreateTokenValidateTokenRoundTrip(t *testing.T) {
+	svc := newTestAuthService()
+
+	token, err := svc.CreateToken(RoleWriter, "actor-pubkey")
+	if err != nil {
+		t.Fatalf("CreateToken failed: %v", err)
+	}
+
+	role, actor, err := svc.ValidateToken(token)
+	if err != nil {
+		t.Fatalf("ValidateToken failed: %v", err)
+	}
+	if role != RoleWriter {
+		t.Errorf("expected role %q, got %q", RoleWriter, role)
+	}
+	if actor != "actor-pubkey" {
+		t.Errorf("expected actor %q, got %q", "actor-pubkey", actor)
+	}
+}
+
+func TestValidateTokenRejectsUnknownSigningMethod(t *testing.T) {
+	svc := newTestAuthService()
+
+	claims := tokenClaims{
+		Role: RoleAdmin,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:      "tok_attacker",
+			Subject: "attacker",
+		},
+	}
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, claims).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if err != nil {
+		t.Fatalf("failed to build alg=none token: %v", err)
+	}
+
+	if _, _, err := svc.ValidateToken(unsigned); err == nil {
+		t.Fatal("expected ValidateToken to reject a token not signed with HMAC")
+	}
+}
+
+func TestRevokeTokenRejectsFutureValidation(t *testing.T) {
+	svc := newTestAuthService()
+
+	token, err := svc.CreateToken(RoleReader, "actor-pubkey")
+	if err != nil {
+		t.Fatalf("CreateToken failed: %v", err)
+	}
+
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &tokenClaims{})
+	if err != nil {
+		t.Fatalf("failed to parse token claims: %v", err)
+	}
+	jti := parsed.Claims.(*tokenClaims).ID
+
+	if err := svc.RevokeToken(jti); err != nil {
+		t.Fatalf("RevokeToken failed: %v", err)
+	}
+
+	if _, _, err := svc.ValidateToken(token); err == nil {
+		t.Fatal("expected ValidateToken to reject a revoked token")
+	}
+}
+
+func TestRoleAllows(t *testing.T) {
+	if !RoleAdmin.Allows(RoleWriter) {
+		t.Error("expected admin to allow writer-scoped actions")
+	}
+	if RoleReader.Allows(RoleWriter) {
+		t.Error("expected reader to not allow writer-scoped actions")
+	}
+	if Role("bogus").Valid() {
+		t.Error("expected an unknown role to be invalid")
+	}
+}
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/ghostspeak/cli-go/internal/domain"
+)
+
+// ExportFormat enumerates the output formats accepted by `--format` on the export-capable
+// agent commands. "table" is handled by the caller via the existing lipgloss rendering and
+// never reaches ExportAgents/ExportAnalytics.
+const (
+	ExportFormatTable = "table"
+	ExportFormatJSON  = "json"
+	ExportFormatCSV   = "csv"
+)
+
+var agentExportColumns = []string{
+	"id", "owner", "pda", "type", "status",
+	"total_jobs", "completed_jobs", "success_rate", "average_rating",
+	"total_earnings_sol", "created_at", "updated_at", "metadata_uri",
+}
+
+// ExportAgents streams the active wallet's agents to w as CSV or JSON, mirroring the
+// columns rendered by `ghost agent list`.
+func (s *AgentService) ExportAgents(w io.Writer, format string) error {
+	agents, err := s.ListAgents()
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case ExportFormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(agents)
+	case ExportFormatCSV:
+		return ExportAgentsCSV(w, agents)
+	default:
+		return fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// ExportAgentsCSV writes agents to w in the canonical agent CSV column layout, shared by
+// the local AgentService and RemoteAgentClient so `--format csv` looks the same regardless
+// of `--remote`.
+func ExportAgentsCSV(w io.Writer, agents []*domain.Agent) error {
+	cw := csv.NewWriter(w)
+	defer cw.Flush()
+
+	if err := cw.Write(agentExportColumns); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, agent := range agents {
+		row := []string{
+			agent.ID,
+			agent.Owner,
+			agent.PDA,
+			agent.AgentType.String(),
+			string(agent.Status),
+			strconv.Itoa(agent.TotalJobs),
+			strconv.Itoa(agent.CompletedJobs),
+			strconv.FormatFloat(agent.SuccessRate, 'f', 2, 64),
+			strconv.FormatFloat(agent.AverageRating, 'f', 2, 64),
+			strconv.FormatFloat(domain.LamportsToSOL(agent.TotalEarnings), 'f', 4, 64),
+			agent.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			agent.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			agent.MetadataURI,
+		}
+		if err := cw.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV row for agent %s: %w", agent.ID, err)
+		}
+	}
+
+	return cw.Error()
+}
+
+// ExportAnalytics streams the aggregated agent analytics to w as CSV or JSON.
+func (s *AgentService) ExportAnalytics(w io.Writer, format string) error {
+	analytics, err := s.GetAnalytics()
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case ExportFormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(analytics)
+	case ExportFormatCSV:
+		return ExportAnalyticsCSV(w, analytics)
+	default:
+		return fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// ExportAnalyticsCSV writes analytics to w in the canonical analytics CSV column layout,
+// shared by the local AgentService and RemoteAgentClient.
+func ExportAnalyticsCSV(w io.Writer, analytics *domain.Analytics) error {
+	cw := csv.NewWriter(w)
+	defer cw.Flush()
+
+	header := []string{
+		"total_agents", "active_agents", "total_jobs", "completed_jobs",
+		"success_rate", "average_rating", "total_earnings_sol", "updated_at",
+	}
+	if err := cw.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	row := []string{
+		strconv.Itoa(analytics.TotalAgents),
+		strconv.Itoa(analytics.ActiveAgents),
+		strconv.Itoa(analytics.TotalJobs),
+		strconv.Itoa(analytics.CompletedJobs),
+		strconv.FormatFloat(analytics.SuccessRate, 'f', 2, 64),
+		strconv.FormatFloat(analytics.AverageRating, 'f', 2, 64),
+		strconv.FormatFloat(analytics.TotalEarningsSOL, 'f', 4, 64),
+		analytics.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if err := cw.Write(row); err != nil {
+		return fmt.Errorf("failed to write CSV row: %w", err)
+	}
+
+	return cw.Error()
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghostspeak/cli-go/internal/domain"
+	"gopkg.in/yaml.v3"
+)
+
+// ManifestAgentEntry describes a single agent inside an agent registration manifest.
+type ManifestAgentEntry struct {
+	Name             string            `json:"name" yaml:"name"`
+	Description      string            `json:"description" yaml:"description"`
+	Type             string            `json:"type" yaml:"type"`
+	Capabilities     []string          `json:"capabilities" yaml:"capabilities"`
+	Version          string            `json:"version" yaml:"version"`
+	ImageURL         string            `json:"image_url,omitempty" yaml:"image_url,omitempty"`
+	MetadataOverride map[string]string `json:"ipfs_metadata,omitempty" yaml:"ipfs_metadata,omitempty"`
+}
+
+// AgentManifest is the top-level document accepted by `ghost agent register --manifest`.
+type AgentManifest struct {
+	Agents []ManifestAgentEntry `json:"agents" yaml:"agents"`
+}
+
+// LoadAgentManifest reads a YAML or JSON manifest file, dispatching on file extension.
+func LoadAgentManifest(path string) (*AgentManifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	var manifest AgentManifest
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		if err := json.Unmarshal(data, &manifest); err != nil {
+			return nil, fmt.Errorf("failed to parse JSON manifest: %w", err)
+		}
+	default:
+		if err := yaml.Unmarshal(data, &manifest); err != nil {
+			return nil, fmt.Errorf("failed to parse YAML manifest: %w", err)
+		}
+	}
+
+	if len(manifest.Agents) == 0 {
+		return nil, fmt.Errorf("manifest %s contains no agents", path)
+	}
+
+	return &manifest, nil
+}
+
+// ToRegisterParams converts a manifest entry into domain.RegisterAgentParams, defaulting
+// version the same way the interactive `ghost agent register` flow does. Callers outside
+// this package (e.g. ape.AuthorizeManifest) use this to authorize against the same
+// canonicalized agent type RegisterAgentsFromManifest will actually register.
+func (e ManifestAgentEntry) ToRegisterParams() (domain.RegisterAgentParams, error) {
+	agentType, err := domain.ParseAgentType(e.Type)
+	if err != nil {
+		return domain.RegisterAgentParams{}, fmt.Errorf("agent %q: %w", e.Name, err)
+	}
+
+	capabilities := make([]string, len(e.Capabilities))
+	for i, c := range e.Capabilities {
+		capabilities[i] = strings.TrimSpace(c)
+	}
+
+	version := e.Version
+	if version == "" {
+		version = "1.0.0"
+	}
+
+	return domain.RegisterAgentParams{
+		Name:         e.Name,
+		Description:  e.Description,
+		AgentType:    agentType,
+		Capabilities: capabilities,
+		Version:      version,
+		ImageURL:     e.ImageURL,
+	}, nil
+}
+
+// applyMetadataOverride overlays e's ipfs_metadata entries onto metadata, letting a
+// manifest tweak individual IPFS fields (e.g. a one-off description or image) without
+// duplicating the rest of the entry. Unrecognized keys are ignored rather than rejected,
+// since the manifest format is expected to gain new overridable fields over time.
+func (e ManifestAgentEntry) applyMetadataOverride(metadata *domain.AgentMetadata) {
+	for key, value := range e.MetadataOverride {
+		switch key {
+		case "name":
+			metadata.Name = value
+		case "description":
+			metadata.Description = value
+		case "version":
+			metadata.Version = value
+		case "image_url":
+			metadata.ImageURL = value
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ghostspeak/cli-go/internal/config"
+	"github.com/ghostspeak/cli-go/internal/ports"
+)
+
+// DefaultWorkspace is the workspace used when none has been created or selected yet.
+const DefaultWorkspace = "default"
+
+const workspaceIndexKey = "workspaces:index"
+
+// Workspace is a named, isolated environment with its own active wallet, cache
+// namespace, and IPFS pinning credentials.
+type Workspace struct {
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// WorkspaceService manages the set of workspaces and which one is active.
+type WorkspaceService struct {
+	cfg     *config.Config
+	storage ports.Storage
+}
+
+// NewWorkspaceService creates a new workspace service.
+func NewWorkspaceService(cfg *config.Config, storage ports.Storage) *WorkspaceService {
+	return &WorkspaceService{cfg: cfg, storage: storage}
+}
+
+// Active returns the currently selected workspace name, defaulting to
+// DefaultWorkspace when none has been configured.
+func (s *WorkspaceService) Active() string {
+	if s.cfg.Workspace.Active == "" {
+		return DefaultWorkspace
+	}
+	return s.cfg.Workspace.Active
+}
+
+// CreateWorkspace registers a new workspace. It does not switch to it.
+func (s *WorkspaceService) CreateWorkspace(name string) (*Workspace, error) {
+	if name == "" {
+		return nil, fmt.Errorf("workspace name cannot be empty")
+	}
+
+	workspaces, err := s.ListWorkspaces()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, w := range workspaces {
+		if w.Name == name {
+			return nil, fmt.Errorf("workspace %q already exists", name)
+		}
+	}
+
+	workspace := &Workspace{Name: name, CreatedAt: time.Now()}
+	workspaces = append(workspaces, workspace)
+
+	if err := s.storage.SetJSON(workspaceIndexKey, workspaces); err != nil {
+		return nil, fmt.Errorf("failed to save workspace: %w", err)
+	}
+
+	config.Infof("Created workspace: %s", name)
+
+	return workspace, nil
+}
+
+// ListWorkspaces returns every known workspace, always including the implicit
+// "default" workspace even if it was never explicitly created.
+func (s *WorkspaceService) ListWorkspaces() ([]*Workspace, error) {
+	var workspaces []*Workspace
+	if err := s.storage.GetJSON(workspaceIndexKey, &workspaces); err != nil {
+		workspaces = nil
+	}
+
+	for _, w := range workspaces {
+		if w.Name == DefaultWorkspace {
+			return workspaces, nil
+		}
+	}
+
+	return append([]*Workspace{{Name: DefaultWorkspace}}, workspaces...), nil
+}
+
+// SwitchWorkspace makes name the active workspace and persists the choice to config.
+func (s *WorkspaceService) SwitchWorkspace(name string) error {
+	workspaces, err := s.ListWorkspaces()
+	if err != nil {
+		return err
+	}
+
+	var found bool
+	for _, w := range workspaces {
+		if w.Name == name {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("unknown workspace %q, create it first with 'ghost workspace create'", name)
+	}
+
+	s.cfg.Workspace.Active = name
+	if err := config.SaveConfig(s.cfg); err != nil {
+		return fmt.Errorf("failed to persist active workspace: %w", err)
+	}
+
+	config.Infof("Switched to workspace: %s", name)
+
+	return nil
+}
+
+// DeleteWorkspace removes a non-default workspace. It refuses to delete the
+// workspace that is currently active.
+func (s *WorkspaceService) DeleteWorkspace(name string) error {
+	if name == DefaultWorkspace {
+		return fmt.Errorf("cannot delete the default workspace")
+	}
+	if name == s.Active() {
+		return fmt.Errorf("cannot delete the active workspace, switch to another one first")
+	}
+
+	workspaces, err := s.ListWorkspaces()
+	if err != nil {
+		return err
+	}
+
+	remaining := make([]*Workspace, 0, len(workspaces))
+	var found bool
+	for _, w := range workspaces {
+		if w.Name == name {
+			found = true
+			continue
+		}
+		remaining = append(remaining, w)
+	}
+	if !found {
+		return fmt.Errorf("unknown workspace %q", name)
+	}
+
+	if err := s.storage.SetJSON(workspaceIndexKey, remaining); err != nil {
+		return fmt.Errorf("failed to save workspaces: %w", err)
+	}
+
+	config.Infof("Deleted workspace: %s", name)
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package itests
+
+import (
+	"testing"
+
+	"github.com/ghostspeak/cli-go/internal/conformance"
+)
+
+// TestAgentConformance replays every vector in itests/vectors/ and fails if
+// solClient.ParseAgentAccount or solClient.DeriveAgentPDA stop producing byte-identical
+// output, protecting against silent breakage when the on-chain agent layout changes.
+func TestAgentConformance(t *testing.T) {
+	results, err := conformance.Run("vectors", false)
+	if err != nil {
+		t.Fatalf("failed to run conformance vectors: %v", err)
+	}
+
+	if len(results) == 0 {
+		t.Fatal("no conformance vectors found under vectors/")
+	}
+
+	for _, r := range results {
+		if !r.Passed {
+			t.Errorf("vector %s: %s", r.Name, r.Mismatch)
+		}
+	}
+}